@@ -0,0 +1,118 @@
+package csvhelper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// ErrUnsupportedFieldType is returned when a struct field has no registered
+// converter, no builtin support and does not implement Unmarshaler.
+var ErrUnsupportedFieldType = errors.New("unsupported field type")
+
+// DefaultTimeLayout is used to parse time.Time fields when MarshalConfig.TimeLayout is empty.
+var DefaultTimeLayout = time.RFC3339
+
+// Converter converts a raw CSV cell into dst, which is always addressable and settable.
+type Converter func(raw string, dst reflect.Value) error
+
+// Unmarshaler lets a struct field take full control over how it's decoded from a CSV cell.
+type Unmarshaler interface {
+	UnmarshalCSV(raw string) error
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// kindConverters are the built-in conversions used when a field's type has no
+// user-registered converter and isn't time.Time.
+var kindConverters = map[reflect.Kind]Converter{
+	reflect.String:  func(raw string, dst reflect.Value) error { dst.SetString(raw); return nil },
+	reflect.Bool:    convertBool,
+	reflect.Int:     convertInt,
+	reflect.Int8:    convertInt,
+	reflect.Int16:   convertInt,
+	reflect.Int32:   convertInt,
+	reflect.Int64:   convertInt,
+	reflect.Uint:    convertUint,
+	reflect.Uint8:   convertUint,
+	reflect.Uint16:  convertUint,
+	reflect.Uint32:  convertUint,
+	reflect.Uint64:  convertUint,
+	reflect.Float32: convertFloat,
+	reflect.Float64: convertFloat,
+}
+
+func convertInt(raw string, dst reflect.Value) error {
+	v, err := strconv.ParseInt(raw, 10, dst.Type().Bits())
+	if err != nil {
+		return err
+	}
+	dst.SetInt(v)
+	return nil
+}
+
+func convertUint(raw string, dst reflect.Value) error {
+	v, err := strconv.ParseUint(raw, 10, dst.Type().Bits())
+	if err != nil {
+		return err
+	}
+	dst.SetUint(v)
+	return nil
+}
+
+func convertFloat(raw string, dst reflect.Value) error {
+	v, err := strconv.ParseFloat(raw, dst.Type().Bits())
+	if err != nil {
+		return err
+	}
+	dst.SetFloat(v)
+	return nil
+}
+
+func convertBool(raw string, dst reflect.Value) error {
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		return err
+	}
+	dst.SetBool(v)
+	return nil
+}
+
+// convertValue assigns raw into dst, dispatching in order on: the Unmarshaler
+// interface, a user-registered converter for dst's exact type, the builtin
+// time.Time layout-aware parser, and finally the builtin converter for dst's kind.
+func convertValue(raw string, dst reflect.Value, cfg MarshalConfig) error {
+	if dst.CanAddr() {
+		if u, ok := dst.Addr().Interface().(Unmarshaler); ok {
+			return u.UnmarshalCSV(raw)
+		}
+	}
+
+	if fn, ok := cfg.Converters[dst.Type()]; ok {
+		return fn(raw, dst)
+	}
+
+	if dst.Type() == timeType {
+		layout := cfg.TimeLayout
+		if layout == "" {
+			layout = DefaultTimeLayout
+		}
+
+		t, err := time.Parse(layout, raw)
+		if err != nil {
+			return err
+		}
+
+		dst.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	fn, ok := kindConverters[dst.Kind()]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedFieldType, dst.Type())
+	}
+
+	return fn(raw, dst)
+}
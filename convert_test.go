@@ -0,0 +1,77 @@
+package csvhelper
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// typedModelT struct exercises the builtin kind and time.Time converters
+type typedModelT struct {
+	Name    string    `csv_column_name:"name"`
+	Age     int       `csv_column_name:"age"`
+	Score   float64   `csv_column_name:"score"`
+	Active  bool      `csv_column_name:"active"`
+	JoinsAt time.Time `csv_column_name:"joins_at"`
+}
+
+var typedCsvFile = `name,age,score,active,joins_at
+Alice,30,9.5,true,2023-05-01T00:00:00Z
+`
+
+func TestMarshalTypedFields(t *testing.T) {
+	csvHelper := New[typedModelT]()
+	csvHelper = csvHelper.ReadAll(bytes.NewBufferString(typedCsvFile))
+
+	got, err := csvHelper.Marshal(MarshalConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, []typedModelT{
+		{
+			Name:    "Alice",
+			Age:     30,
+			Score:   9.5,
+			Active:  true,
+			JoinsAt: time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}, got)
+}
+
+func TestMarshalTypedFieldsConversionError(t *testing.T) {
+	csvHelper := New[typedModelT]()
+	csvHelper = csvHelper.ReadAll(bytes.NewBufferString(`name,age,score,active,joins_at
+Alice,notanumber,9.5,true,2023-05-01T00:00:00Z
+`))
+
+	_, err := csvHelper.Marshal(MarshalConfig{})
+	assert.Error(t, err)
+
+	var fieldErr *FieldError
+	assert.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "Age", fieldErr.Field)
+}
+
+// colorT is a custom type that implements Unmarshaler
+type colorT struct {
+	hex string
+}
+
+func (c *colorT) UnmarshalCSV(raw string) error {
+	c.hex = raw
+	return nil
+}
+
+type unmarshalerModelT struct {
+	Name  string `csv_column_name:"name"`
+	Color colorT `csv_column_name:"color"`
+}
+
+func TestMarshalUnmarshaler(t *testing.T) {
+	csvHelper := New[unmarshalerModelT]()
+	csvHelper = csvHelper.ReadAll(bytes.NewBufferString("name,color\nAlice,#fff\n"))
+
+	got, err := csvHelper.Marshal(MarshalConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, []unmarshalerModelT{{Name: "Alice", Color: colorT{hex: "#fff"}}}, got)
+}
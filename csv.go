@@ -1,19 +1,34 @@
 package csvhelper
 
 import (
-	"bytes"
 	"encoding/csv"
 	"errors"
+	"fmt"
+	"io"
 	"reflect"
 	"strings"
 )
 
 type MarshalConfig struct {
 	SkipValidation bool
+	// Converters lets callers override how a given field type is decoded,
+	// taking precedence over the builtin kind/time.Time conversions.
+	Converters map[reflect.Type]Converter
+	// TimeLayout is used to parse time.Time fields. Defaults to DefaultTimeLayout.
+	TimeLayout string
+	// OnError controls how Marshal and Stream react to a per-row conversion
+	// failure. Defaults to StopOnError.
+	OnError ErrorPolicy
+	// Format, when set, switches Marshal/Stream to positional header mode:
+	// e.g. "$name $age !ignored $email" binds columns by position instead of
+	// parsing/requiring a header row. FormatDelimiter defaults to a single space.
+	Format          string
+	FormatDelimiter string
 }
 
 type ValidationConfig struct {
 	SkipValidation bool
+	Format         string
 }
 
 var csvColumnNameTag = "csv_column_name"
@@ -24,12 +39,29 @@ var ErrMissingRequiredTag = errors.New("missing required tag")
 var ErrDuplicatedTag = errors.New("duplicated tag")
 var ErrUninitializedRecords = errors.New("uninitialized records")
 
+// FieldError reports the row, column and struct field involved in a failed conversion.
+type FieldError struct {
+	Row    int
+	Column string
+	Field  string
+	Err    error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("row %d: column %q: field %q: %v", e.Row, e.Column, e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
 type CsvHelper[T any] interface {
-	ReadAll(buffer *bytes.Buffer) CsvHelper[T]
+	ReadAll(buffer io.Reader) CsvHelper[T]
 	Validate() (bool, error)
 	Records() ([][]string, error)
 	Error() error
 	Marshal(cfg MarshalConfig) ([]T, error)
+	Stream(buffer io.Reader, cfg MarshalConfig, fn func(row int, item T, err error) error) error
 }
 
 type csvHelperImpl[T any] struct {
@@ -41,7 +73,7 @@ func New[T any]() CsvHelper[T] {
 	return &csvHelperImpl[T]{}
 }
 
-func (c csvHelperImpl[T]) ReadAll(buffer *bytes.Buffer) CsvHelper[T] {
+func (c csvHelperImpl[T]) ReadAll(buffer io.Reader) CsvHelper[T] {
 	c.records, c.err = csv.NewReader(buffer).ReadAll()
 	return c
 }
@@ -71,16 +103,27 @@ func (c csvHelperImpl[T]) Error() error {
 }
 
 func (c csvHelperImpl[T]) Marshal(cfg MarshalConfig) ([]T, error) {
-	if err := c.validate(ValidationConfig{cfg.SkipValidation}); err != nil {
+	if err := c.validate(ValidationConfig{SkipValidation: cfg.SkipValidation, Format: cfg.Format}); err != nil {
 		return nil, err
 	}
 
-	indexToFieldName, err := c.mapIndexToField(c.records)
+	indexToFieldName, err := c.resolveIndexToFieldName(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.assign(c.records, indexToFieldName)
+	return c.assign(c.records, indexToFieldName, cfg)
+}
+
+// resolveIndexToFieldName binds CSV columns to struct fields either from the
+// header row (the default) or, when cfg.Format is set, positionally from the
+// format string.
+func (c csvHelperImpl[T]) resolveIndexToFieldName(cfg MarshalConfig) (map[int]string, error) {
+	if cfg.Format != "" {
+		return parseFormat[T](cfg.Format, cfg.FormatDelimiter)
+	}
+
+	return c.mapIndexToField(c.records)
 }
 
 func (c *csvHelperImpl[T]) validate(cfg ValidationConfig) error {
@@ -93,12 +136,14 @@ func (c *csvHelperImpl[T]) validate(cfg ValidationConfig) error {
 			return err
 		}
 
-		var model T
-		csvHeaders := c.records[0]
-		modelSize := reflect.ValueOf(model).NumField()
+		if cfg.Format == "" {
+			var model T
+			csvHeaders := c.records[0]
+			modelSize := reflect.ValueOf(model).NumField()
 
-		if len(csvHeaders) != modelSize {
-			return ErrInvalidHeaderSize
+			if len(csvHeaders) != modelSize {
+				return ErrInvalidHeaderSize
+			}
 		}
 	}
 
@@ -168,24 +213,94 @@ func (csvHelperImpl[T]) mapIndexToField(csv [][]string) (map[int]string, error)
 	return indexToFieldName, nil
 }
 
-func (csvHelperImpl[T]) assign(records [][]string, indexToFieldName map[int]string) ([]T, error) {
+func (csvHelperImpl[T]) assign(records [][]string, indexToFieldName map[int]string, cfg MarshalConfig) ([]T, error) {
 	var output []T
+	var multiErr MultiError
+
+	var header []string
+	if cfg.Format == "" {
+		header = records[0]
+	}
+
+	var fieldRules map[string][]ruleToken
+	var aliases map[string][]string
+
+	if !cfg.SkipValidation {
+		rules, fieldAliases, err := compileFieldRules[T]()
+		if err != nil {
+			return nil, err
+		}
+
+		fieldRules, aliases = rules, fieldAliases
+	}
+
+	columns := fieldToColumnMap[T]()
 
 	for index, record := range records {
-		var model T
 		headerLine := 0
 
-		if index == headerLine {
+		if cfg.Format == "" && index == headerLine {
 			continue
 		}
 
-		for index, data := range record {
-			modelField := indexToFieldName[index]
-			reflect.ValueOf(&model).Elem().FieldByName(modelField).SetString(data)
+		row := index
+		if cfg.Format != "" {
+			// There's no header row to account for, so the row numbers stay
+			// 1-based like the header path and Stream, instead of starting at 0.
+			row = index + 1
+		}
+
+		model, err := assignRecord[T](record, indexToFieldName, header, row, cfg)
+		if err == nil && !cfg.SkipValidation {
+			if verrs := validateModel[T](model, fieldRules, aliases, columns, row); len(verrs) > 0 {
+				err = verrs
+			}
+		}
+
+		if err != nil {
+			switch cfg.OnError {
+			case SkipRow:
+				continue
+			case CollectErrors:
+				multiErr.Errors = append(multiErr.Errors, err)
+				continue
+			default: // StopOnError
+				return nil, err
+			}
 		}
 
 		output = append(output, model)
 	}
 
+	if len(multiErr.Errors) > 0 {
+		return output, &multiErr
+	}
+
 	return output, nil
 }
+
+// assignRecord converts a single CSV record into a T, dispatching field
+// conversion through convertValue and wrapping failures in a *FieldError.
+func assignRecord[T any](record []string, indexToFieldName map[int]string, header []string, row int, cfg MarshalConfig) (T, error) {
+	var model T
+	rv := reflect.ValueOf(&model).Elem()
+
+	for col, data := range record {
+		modelField := indexToFieldName[col]
+		if modelField == "" {
+			continue
+		}
+
+		column := modelField
+		if col < len(header) {
+			column = header[col]
+		}
+
+		dst := rv.FieldByName(modelField)
+		if err := convertValue(data, dst, cfg); err != nil {
+			return model, &FieldError{Row: row, Column: column, Field: modelField, Err: err}
+		}
+	}
+
+	return model, nil
+}
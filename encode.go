@@ -0,0 +1,172 @@
+package csvhelper
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QuoteMode controls how Encode quotes fields.
+type QuoteMode int
+
+const (
+	// QuoteAuto quotes a field only when encoding/csv requires it (the field
+	// contains the delimiter, a quote, a carriage return or a newline, begins
+	// with whitespace, or is `\.`), delegating to encoding/csv.
+	QuoteAuto QuoteMode = iota
+	// QuoteAll wraps every field in quotes.
+	QuoteAll
+	// QuoteNone never quotes a field, even if that produces ambiguous output.
+	QuoteNone
+)
+
+type EncodeConfig struct {
+	// Delimiter separates fields. Defaults to ','.
+	Delimiter rune
+	Quote     QuoteMode
+	// OmitHeader skips writing the header row.
+	OmitHeader bool
+	// ColumnOrder overrides the header order (and the order fields are
+	// written in). Each entry must match a csv_column_name tag on T. Defaults
+	// to struct-declaration order.
+	ColumnOrder []string
+}
+
+// Encode writes items to w as CSV, reusing validateModelTags to reject a
+// misconfigured T before anything is written.
+func Encode[T any](w io.Writer, items []T, cfg EncodeConfig) error {
+	if err := (&csvHelperImpl[T]{}).validateModelTags(); err != nil {
+		return err
+	}
+
+	fieldByColumn, columns, err := columnOrder[T](cfg.ColumnOrder)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(items)+1)
+	if !cfg.OmitHeader {
+		rows = append(rows, columns)
+	}
+
+	for _, item := range items {
+		rv := reflect.ValueOf(item)
+		record := make([]string, len(columns))
+
+		for i, column := range columns {
+			record[i] = stringify(rv.FieldByName(fieldByColumn[column]))
+		}
+
+		rows = append(rows, record)
+	}
+
+	return writeRows(w, rows, cfg)
+}
+
+// Marshal encodes items using the default EncodeConfig and returns the result.
+func Marshal[T any](items []T) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := Encode(&buf, items, EncodeConfig{}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// columnOrder resolves the header/write order for T: struct-declaration order
+// by default, or override once every entry is confirmed to match a tagged field.
+func columnOrder[T any](override []string) (map[string]string, []string, error) {
+	var model T
+	rt := reflect.TypeOf(model)
+	modelSize := rt.NumField()
+
+	fieldByColumn := make(map[string]string, modelSize)
+	declared := make([]string, 0, modelSize)
+
+	for i := 0; i < modelSize; i++ {
+		column := rt.Field(i).Tag.Get(csvColumnNameTag)
+		fieldByColumn[column] = rt.Field(i).Name
+		declared = append(declared, column)
+	}
+
+	if len(override) == 0 {
+		return fieldByColumn, declared, nil
+	}
+
+	for _, column := range override {
+		if _, ok := fieldByColumn[column]; !ok {
+			return nil, nil, ErrInvalidHeaderValues
+		}
+	}
+
+	return fieldByColumn, override, nil
+}
+
+func writeRows(w io.Writer, rows [][]string, cfg EncodeConfig) error {
+	delim := cfg.Delimiter
+	if delim == 0 {
+		delim = ','
+	}
+
+	if cfg.Quote == QuoteAll || cfg.Quote == QuoteNone {
+		return writeRowsManual(w, rows, delim, cfg.Quote)
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Comma = delim
+
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return writer.Error()
+}
+
+func writeRowsManual(w io.Writer, rows [][]string, delim rune, quote QuoteMode) error {
+	for _, row := range rows {
+		fields := make([]string, len(row))
+
+		for i, field := range row {
+			if quote == QuoteAll {
+				fields[i] = `"` + strings.ReplaceAll(field, `"`, `""`) + `"`
+			} else {
+				fields[i] = field
+			}
+		}
+
+		if _, err := io.WriteString(w, strings.Join(fields, string(delim))+"\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stringify is the Encode-side counterpart to convertValue: it formats a
+// field back into its CSV cell representation.
+func stringify(v reflect.Value) string {
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).Format(DefaultTimeLayout)
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
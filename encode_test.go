@@ -0,0 +1,64 @@
+package csvhelper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode(t *testing.T) {
+	items := []validModelT{
+		{Field1: "value1", Field2: "value2", Field3: "value3"},
+		{Field1: "value4", Field2: "value5", Field3: "value6"},
+	}
+
+	var buf bytes.Buffer
+	err := Encode(&buf, items, EncodeConfig{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "column1,column2,column3\nvalue1,value2,value3\nvalue4,value5,value6\n", buf.String())
+}
+
+func TestEncodeOmitHeader(t *testing.T) {
+	items := []validModelT{{Field1: "value1", Field2: "value2", Field3: "value3"}}
+
+	var buf bytes.Buffer
+	err := Encode(&buf, items, EncodeConfig{OmitHeader: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "value1,value2,value3\n", buf.String())
+}
+
+func TestEncodeColumnOrder(t *testing.T) {
+	items := []validModelT{{Field1: "value1", Field2: "value2", Field3: "value3"}}
+
+	var buf bytes.Buffer
+	err := Encode(&buf, items, EncodeConfig{ColumnOrder: []string{"column3", "column1", "column2"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "column3,column1,column2\nvalue3,value1,value2\n", buf.String())
+}
+
+func TestEncodeInvalidColumnOrder(t *testing.T) {
+	var buf bytes.Buffer
+	err := Encode(&buf, []validModelT{}, EncodeConfig{ColumnOrder: []string{"notacolumn"}})
+
+	assert.ErrorIs(t, err, ErrInvalidHeaderValues)
+}
+
+func TestEncodeInvalidModel(t *testing.T) {
+	var buf bytes.Buffer
+	err := Encode(&buf, []missingTagModelT{}, EncodeConfig{})
+
+	assert.ErrorIs(t, err, ErrMissingRequiredTag)
+}
+
+func TestMarshalEncode(t *testing.T) {
+	items := []validModelT{{Field1: "value1", Field2: "value2", Field3: "value3"}}
+
+	got, err := Marshal(items)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "column1,column2,column3\nvalue1,value2,value3\n", string(got))
+}
@@ -0,0 +1,59 @@
+package csvhelper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// defaultFormatDelimiter separates tokens in MarshalConfig.Format when
+// FormatDelimiter is left empty.
+var defaultFormatDelimiter = " "
+
+var ErrUnknownFormatField = errors.New("format references a field with no matching csv_column_name tag")
+var ErrDuplicateFormatField = errors.New("field appears more than once in format")
+
+// parseFormat turns a format string like "$name $age !ignored $email" into a
+// column-index -> field-name map, positionally binding each $field token to
+// the struct field whose csv_column_name tag matches it. Tokens starting with
+// "!" are explicit skip markers; every other token must resolve to a real
+// tagged field, and no field may be bound more than once.
+func parseFormat[T any](format, delimiter string) (map[int]string, error) {
+	if delimiter == "" {
+		delimiter = defaultFormatDelimiter
+	}
+
+	var model T
+	rt := reflect.TypeOf(model)
+	columnToField := make(map[string]string, rt.NumField())
+
+	for i := 0; i < rt.NumField(); i++ {
+		columnToField[rt.Field(i).Tag.Get(csvColumnNameTag)] = rt.Field(i).Name
+	}
+
+	indexToFieldName := make(map[int]string)
+	seen := make(map[string]bool)
+
+	for i, token := range strings.Split(format, delimiter) {
+		if strings.HasPrefix(token, "!") {
+			continue
+		}
+
+		column := strings.TrimPrefix(token, "$")
+
+		field, ok := columnToField[column]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownFormatField, column)
+		}
+
+		if seen[field] {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateFormatField, column)
+		}
+		seen[field] = true
+
+		indexToFieldName[i] = field
+	}
+
+	return indexToFieldName, nil
+}
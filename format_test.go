@@ -0,0 +1,97 @@
+package csvhelper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// accessLogModelT mirrors a headerless access-log style CSV
+type accessLogModelT struct {
+	Name  string `csv_column_name:"name"`
+	Age   string `csv_column_name:"age"`
+	Email string `csv_column_name:"email"`
+}
+
+var accessLogCsvFile = `Alice,30,alice@example.com
+Bob,40,bob@example.com
+`
+
+func TestMarshalFormat(t *testing.T) {
+	csvHelper := New[accessLogModelT]()
+	csvHelper = csvHelper.ReadAll(bytes.NewBufferString(accessLogCsvFile))
+
+	got, err := csvHelper.Marshal(MarshalConfig{Format: "$name $age $email"})
+	assert.NoError(t, err)
+	assert.Equal(t, []accessLogModelT{
+		{Name: "Alice", Age: "30", Email: "alice@example.com"},
+		{Name: "Bob", Age: "40", Email: "bob@example.com"},
+	}, got)
+}
+
+func TestMarshalFormatSkipMarker(t *testing.T) {
+	csvHelper := New[accessLogModelT]()
+	csvHelper = csvHelper.ReadAll(bytes.NewBufferString(`Alice,ignored,30,alice@example.com
+`))
+
+	got, err := csvHelper.Marshal(MarshalConfig{Format: "$name !ip $age $email"})
+	assert.NoError(t, err)
+	assert.Equal(t, []accessLogModelT{{Name: "Alice", Age: "30", Email: "alice@example.com"}}, got)
+}
+
+func TestMarshalFormatUnknownField(t *testing.T) {
+	csvHelper := New[accessLogModelT]()
+	csvHelper = csvHelper.ReadAll(bytes.NewBufferString(accessLogCsvFile))
+
+	_, err := csvHelper.Marshal(MarshalConfig{Format: "$name $age $nope"})
+	assert.ErrorIs(t, err, ErrUnknownFormatField)
+}
+
+func TestMarshalFormatDuplicateField(t *testing.T) {
+	csvHelper := New[accessLogModelT]()
+	csvHelper = csvHelper.ReadAll(bytes.NewBufferString(accessLogCsvFile))
+
+	_, err := csvHelper.Marshal(MarshalConfig{Format: "$name $name $email"})
+	assert.ErrorIs(t, err, ErrDuplicateFormatField)
+}
+
+func TestMarshalFormatRowNumberMatchesStream(t *testing.T) {
+	badRow := "Alice,notanumber,9.5,true,2023-05-01T00:00:00Z\n"
+
+	marshalHelper := New[typedModelT]()
+	marshalHelper = marshalHelper.ReadAll(bytes.NewBufferString(badRow))
+
+	_, err := marshalHelper.Marshal(MarshalConfig{Format: "$name $age $score $active $joins_at"})
+	assert.Error(t, err)
+
+	var fieldErr *FieldError
+	assert.ErrorAs(t, err, &fieldErr)
+
+	streamHelper := New[typedModelT]()
+	streamErr := streamHelper.Stream(bytes.NewBufferString(badRow), MarshalConfig{Format: "$name $age $score $active $joins_at"}, func(row int, item typedModelT, err error) error {
+		return err
+	})
+	assert.Error(t, streamErr)
+
+	var streamFieldErr *FieldError
+	assert.ErrorAs(t, streamErr, &streamFieldErr)
+
+	assert.Equal(t, streamFieldErr.Row, fieldErr.Row)
+	assert.Equal(t, 1, fieldErr.Row)
+}
+
+func TestStreamFormat(t *testing.T) {
+	csvHelper := New[accessLogModelT]()
+
+	var names []string
+	err := csvHelper.Stream(bytes.NewBufferString(accessLogCsvFile), MarshalConfig{Format: "$name $age $email"}, func(row int, item accessLogModelT, err error) error {
+		if err == nil {
+			names = append(names, item.Name)
+		}
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Alice", "Bob"}, names)
+}
@@ -0,0 +1,406 @@
+package csvhelper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var csvValidateTag = "csv_validate"
+var csvValidateAliasTag = "csv_validate_alias"
+
+var ErrUnknownValidationRule = errors.New("unknown validation rule")
+var ErrRequired = errors.New("value is required")
+var ErrTooShort = errors.New("value is below the minimum")
+var ErrTooLong = errors.New("value is above the maximum")
+var ErrInvalidLength = errors.New("value has an invalid length")
+var ErrPatternMismatch = errors.New("value does not match the required pattern")
+var ErrNotOneOf = errors.New("value is not one of the allowed options")
+var ErrInvalidEmail = errors.New("value is not a valid email")
+
+// Rule evaluates a single field's value against a rule's param, as parsed
+// out of a csv_validate tag entry (e.g. "min=1" -> name "min", param "1").
+type Rule func(value reflect.Value, param string) error
+
+var ruleRegistry = map[string]Rule{
+	"required": requiredRule,
+	"min":      minRule,
+	"max":      maxRule,
+	"len":      lenRule,
+	"regex":    regexRule,
+	"oneof":    oneofRule,
+	"email":    emailRule,
+}
+
+// RegisterRule adds or overrides a named rule usable from a csv_validate tag.
+// Overriding "regex" does not change how csv_validate tags are evaluated: a
+// "regex" tag entry always matches against its own pre-compiled pattern.
+func RegisterRule(name string, fn Rule) {
+	ruleRegistry[name] = fn
+}
+
+// ValidationError reports the row, column, field and rule involved in a failed validation.
+type ValidationError struct {
+	Row    int
+	Column string
+	Field  string
+	Rule   string
+	Param  string
+	Err    error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("row %d: column %q: field %q: rule %q: %v", e.Row, e.Column, e.Field, e.Rule, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors aggregates every rule failure found for a single row.
+type ValidationErrors []*ValidationError
+
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, len(v))
+	for i, e := range v {
+		msgs[i] = e.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+type ruleToken struct {
+	name  string
+	param string
+	// regex is pre-compiled once, at parseValidateTag time, so a "regex" rule
+	// isn't recompiled from its param on every row evaluated.
+	regex *regexp.Regexp
+}
+
+// splitRuleTokens splits a csv_validate tag on rule-separating commas only,
+// tracking (), [] and {} depth so a comma inside a rule's own param - e.g. the
+// bounded quantifier in "regex=^[A-Z]{2,4}$" - doesn't get treated as a
+// separator.
+func splitRuleTokens(tag string) []string {
+	var tokens []string
+	depth := 0
+	start := 0
+
+	for i, r := range tag {
+		switch r {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				tokens = append(tokens, tag[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(tokens, tag[start:])
+}
+
+func parseValidateTag(tag string) ([]ruleToken, error) {
+	if tag == "" {
+		return nil, nil
+	}
+
+	parts := splitRuleTokens(tag)
+	tokens := make([]ruleToken, 0, len(parts))
+
+	for _, part := range parts {
+		name, param, _ := strings.Cut(part, "=")
+		token := ruleToken{name: name, param: param}
+
+		if name == "regex" {
+			re, err := regexp.Compile(param)
+			if err != nil {
+				return nil, err
+			}
+
+			token.regex = re
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+// parseAliasTag parses a csv_validate_alias tag of the form "name=rule1|rule2"
+// into the alias name and its ordered list of alternative rule names. Each
+// alternative must be a name already in the rule registry (via a builtin or
+// RegisterRule) rather than an inline "name=param" spec, since "|" is also a
+// valid regex metacharacter and would make a regex param ambiguous with the
+// alternative separator.
+func parseAliasTag(tag string) (name string, alts []string) {
+	if tag == "" {
+		return "", nil
+	}
+
+	name, rest, _ := strings.Cut(tag, "=")
+
+	return name, strings.Split(rest, "|")
+}
+
+// compileFieldRules reads T's csv_validate and csv_validate_alias tags once
+// per call, returning the per-field rule tokens and the aliases resolved at
+// this model-registration step. Compiling here - rather than inside the
+// rule funcs - means a "regex" rule's pattern is compiled once and reused
+// for every row instead of once per row.
+func compileFieldRules[T any]() (map[string][]ruleToken, map[string][]string, error) {
+	var model T
+	rt := reflect.TypeOf(model)
+	fieldRules := make(map[string][]ruleToken)
+	aliases := make(map[string][]string)
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+
+		if tag := field.Tag.Get(csvValidateTag); tag != "" {
+			tokens, err := parseValidateTag(tag)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			fieldRules[field.Name] = tokens
+		}
+
+		if tag := field.Tag.Get(csvValidateAliasTag); tag != "" {
+			if name, alts := parseAliasTag(tag); name != "" {
+				aliases[name] = alts
+			}
+		}
+	}
+
+	return fieldRules, aliases, nil
+}
+
+func fieldToColumnMap[T any]() map[string]string {
+	var model T
+	rt := reflect.TypeOf(model)
+	columns := make(map[string]string, rt.NumField())
+
+	for i := 0; i < rt.NumField(); i++ {
+		columns[rt.Field(i).Name] = rt.Field(i).Tag.Get(csvColumnNameTag)
+	}
+
+	return columns
+}
+
+// validateModel runs every compiled rule against model's fields, returning
+// one *ValidationError per failing rule. Fields are walked in struct
+// declaration order (rather than ranging fieldRules) so the result is
+// deterministic across runs.
+func validateModel[T any](model T, fieldRules map[string][]ruleToken, aliases map[string][]string, columns map[string]string, row int) ValidationErrors {
+	if len(fieldRules) == 0 {
+		return nil
+	}
+
+	var errs ValidationErrors
+	rv := reflect.ValueOf(model)
+	rt := reflect.TypeOf(model)
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i).Name
+
+		tokens, ok := fieldRules[field]
+		if !ok {
+			continue
+		}
+
+		value := rv.FieldByName(field)
+
+		for _, token := range tokens {
+			if err := evalRule(value, token, aliases); err != nil {
+				errs = append(errs, &ValidationError{
+					Row:    row,
+					Column: columns[field],
+					Field:  field,
+					Rule:   token.name,
+					Param:  token.param,
+					Err:    err,
+				})
+			}
+		}
+	}
+
+	return errs
+}
+
+// evalRule resolves token against the aliases declared on any of T's fields
+// (they share one model-wide map) before falling
+// back to the global rule registry. An alias passes if any of its
+// alternative rules (looked up by name in the registry, carrying no param of
+// their own) passes.
+func evalRule(value reflect.Value, token ruleToken, aliases map[string][]string) error {
+	alts, isAlias := aliases[token.name]
+	if !isAlias {
+		if token.name == "regex" {
+			return regexMatches(value, token.regex)
+		}
+
+		rule, ok := ruleRegistry[token.name]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownValidationRule, token.name)
+		}
+
+		return rule(value, token.param)
+	}
+
+	var lastErr error
+
+	for _, altName := range alts {
+		rule, ok := ruleRegistry[altName]
+		if !ok {
+			lastErr = fmt.Errorf("%w: %s", ErrUnknownValidationRule, altName)
+			continue
+		}
+
+		if err := rule(value, ""); err == nil {
+			return nil
+		} else {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+func requiredRule(value reflect.Value, _ string) error {
+	if value.IsZero() {
+		return ErrRequired
+	}
+
+	return nil
+}
+
+func minRule(value reflect.Value, param string) error {
+	limit, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return err
+	}
+
+	if ruleNumericValue(value) < limit {
+		return ErrTooShort
+	}
+
+	return nil
+}
+
+func maxRule(value reflect.Value, param string) error {
+	limit, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return err
+	}
+
+	if ruleNumericValue(value) > limit {
+		return ErrTooLong
+	}
+
+	return nil
+}
+
+// ruleNumericValue normalizes a field to a float64 for min/max comparisons:
+// strings compare by length, numeric kinds compare by value.
+func ruleNumericValue(value reflect.Value) float64 {
+	switch value.Kind() {
+	case reflect.String:
+		return float64(len(value.String()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return value.Float()
+	default:
+		return 0
+	}
+}
+
+// lenRule checks an exact length: string/slice/array/map by element count,
+// numeric kinds by value (mirroring go-playground/validator's "len").
+func lenRule(value reflect.Value, param string) error {
+	n, err := strconv.Atoi(param)
+	if err != nil {
+		return err
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		if len(value.String()) != n {
+			return ErrInvalidLength
+		}
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if value.Len() != n {
+			return ErrInvalidLength
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if value.Int() != int64(n) {
+			return ErrInvalidLength
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if value.Uint() != uint64(n) {
+			return ErrInvalidLength
+		}
+	case reflect.Float32, reflect.Float64:
+		if value.Float() != float64(n) {
+			return ErrInvalidLength
+		}
+	default:
+		return fmt.Errorf("%w: unsupported kind %s", ErrInvalidLength, value.Kind())
+	}
+
+	return nil
+}
+
+// regexRule is kept in the registry for callers that reach "regex" directly
+// (e.g. a RegisterRule wrapper building its own pattern); evalRule bypasses
+// it for csv_validate tags in favor of the ruleToken.regex pre-compiled by
+// compileFieldRules.
+func regexRule(value reflect.Value, param string) error {
+	re, err := regexp.Compile(param)
+	if err != nil {
+		return err
+	}
+
+	return regexMatches(value, re)
+}
+
+func regexMatches(value reflect.Value, re *regexp.Regexp) error {
+	if !re.MatchString(fmt.Sprintf("%v", value.Interface())) {
+		return ErrPatternMismatch
+	}
+
+	return nil
+}
+
+func oneofRule(value reflect.Value, param string) error {
+	raw := fmt.Sprintf("%v", value.Interface())
+
+	for _, option := range strings.Fields(param) {
+		if option == raw {
+			return nil
+		}
+	}
+
+	return ErrNotOneOf
+}
+
+var emailPattern = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+
+func emailRule(value reflect.Value, _ string) error {
+	if !emailPattern.MatchString(fmt.Sprintf("%v", value.Interface())) {
+		return ErrInvalidEmail
+	}
+
+	return nil
+}
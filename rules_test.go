@@ -0,0 +1,136 @@
+package csvhelper
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	RegisterRule("hexcolor", func(value reflect.Value, _ string) error {
+		return regexRule(value, "^#[0-9a-f]{6}$")
+	})
+	RegisterRule("primarycolor", func(value reflect.Value, _ string) error {
+		return oneofRule(value, "red green blue")
+	})
+	RegisterRule("catordog", func(value reflect.Value, _ string) error {
+		return regexRule(value, "^(cat|dog)$")
+	})
+}
+
+// ruledModelT exercises the builtin required, regex and oneof rules; it also
+// declares an iscolor alias, which no csv_validate entry references
+type ruledModelT struct {
+	Code  string `csv_column_name:"code" csv_validate:"required,regex=^[A-Z]+$" csv_validate_alias:"iscolor=hexcolor|primarycolor"`
+	Grade string `csv_column_name:"grade" csv_validate:"oneof=A B C"`
+}
+
+var validRuledCsvFile = `code,grade
+ABC,A
+`
+
+func TestMarshalValidationRules(t *testing.T) {
+	csvHelper := New[ruledModelT]()
+	csvHelper = csvHelper.ReadAll(bytes.NewBufferString(validRuledCsvFile))
+
+	got, err := csvHelper.Marshal(MarshalConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, []ruledModelT{{Code: "ABC", Grade: "A"}}, got)
+}
+
+func TestMarshalValidationRulesFailure(t *testing.T) {
+	csvHelper := New[ruledModelT]()
+	csvHelper = csvHelper.ReadAll(bytes.NewBufferString(`code,grade
+abc,Z
+`))
+
+	_, err := csvHelper.Marshal(MarshalConfig{})
+	assert.Error(t, err)
+
+	var verrs ValidationErrors
+	assert.ErrorAs(t, err, &verrs)
+	assert.Len(t, verrs, 2)
+}
+
+func TestEvalRuleAlias(t *testing.T) {
+	aliases := map[string][]string{"iscolor": {"hexcolor", "primarycolor"}}
+
+	assert.NoError(t, evalRule(reflect.ValueOf("red"), ruleToken{name: "iscolor"}, aliases))
+	assert.NoError(t, evalRule(reflect.ValueOf("#ffffff"), ruleToken{name: "iscolor"}, aliases))
+	assert.Error(t, evalRule(reflect.ValueOf("purple"), ruleToken{name: "iscolor"}, aliases))
+}
+
+// petModelT's alias alternative carries a regex with a "|" alternation,
+// which would previously be shredded by the "|"-separated alias list itself.
+type petModelT struct {
+	Kind string `csv_column_name:"kind" csv_validate:"iskind" csv_validate_alias:"iskind=catordog"`
+}
+
+func TestMarshalValidationAliasWithPipeRegex(t *testing.T) {
+	for _, valid := range []string{"cat", "dog"} {
+		csvHelper := New[petModelT]()
+		csvHelper = csvHelper.ReadAll(bytes.NewBufferString("kind\n" + valid + "\n"))
+
+		got, err := csvHelper.Marshal(MarshalConfig{})
+		assert.NoError(t, err)
+		assert.Equal(t, []petModelT{{Kind: valid}}, got)
+	}
+
+	csvHelper := New[petModelT]()
+	csvHelper = csvHelper.ReadAll(bytes.NewBufferString("kind\nbird\n"))
+
+	_, err := csvHelper.Marshal(MarshalConfig{})
+	assert.Error(t, err)
+}
+
+func TestLenRuleNumericKind(t *testing.T) {
+	assert.NoError(t, lenRule(reflect.ValueOf(123), "123"))
+	assert.ErrorIs(t, lenRule(reflect.ValueOf(123), "3"), ErrInvalidLength)
+}
+
+func TestSplitRuleTokensKeepsBoundedQuantifierIntact(t *testing.T) {
+	assert.Equal(t, []string{"required", "regex=^[A-Z]{2,4}$", "oneof=A B C"},
+		splitRuleTokens("required,regex=^[A-Z]{2,4}$,oneof=A B C"))
+}
+
+// codeModelT exercises a regex rule with a bounded quantifier, whose comma
+// used to be mistaken for the rule-separating comma in csv_validate.
+type codeModelT struct {
+	Code string `csv_column_name:"code" csv_validate:"regex=^[A-Z]{2,4}$"`
+}
+
+func TestMarshalValidationBoundedQuantifierRegex(t *testing.T) {
+	csvHelper := New[codeModelT]()
+	csvHelper = csvHelper.ReadAll(bytes.NewBufferString("code\nABC\n"))
+
+	got, err := csvHelper.Marshal(MarshalConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, []codeModelT{{Code: "ABC"}}, got)
+}
+
+func TestMarshalValidationBoundedQuantifierRegexFailure(t *testing.T) {
+	csvHelper := New[codeModelT]()
+	csvHelper = csvHelper.ReadAll(bytes.NewBufferString("code\nabcde\n"))
+
+	_, err := csvHelper.Marshal(MarshalConfig{})
+	assert.Error(t, err)
+
+	var verrs ValidationErrors
+	assert.ErrorAs(t, err, &verrs)
+	assert.Equal(t, "regex", verrs[0].Rule)
+}
+
+func TestParseValidateTagPrecompilesRegex(t *testing.T) {
+	tokens, err := parseValidateTag("regex=^[A-Z]{2,4}$")
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 1)
+	assert.NotNil(t, tokens[0].regex)
+	assert.True(t, tokens[0].regex.MatchString("ABC"))
+}
+
+func TestParseValidateTagInvalidRegex(t *testing.T) {
+	_, err := parseValidateTag("regex=([")
+	assert.Error(t, err)
+}
@@ -0,0 +1,143 @@
+package csvhelper
+
+import (
+	"encoding/csv"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// ErrorPolicy controls how Marshal and Stream react to a per-row conversion failure.
+type ErrorPolicy int
+
+const (
+	// StopOnError aborts on the first row conversion failure.
+	StopOnError ErrorPolicy = iota
+	// SkipRow drops the failing row and continues with the rest of the file.
+	SkipRow
+	// CollectErrors accumulates every row failure into a *MultiError returned at the end.
+	CollectErrors
+)
+
+// MultiError aggregates the row failures collected under ErrorPolicy CollectErrors.
+type MultiError struct {
+	Errors []error
+}
+
+func (m *MultiError) Error() string {
+	msgs := make([]string, len(m.Errors))
+	for i, err := range m.Errors {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Stream reads buffer one row at a time, converting each into a T and
+// invoking fn with its row number, the converted item and any
+// conversion/validation error - fn sees every row under every ErrorPolicy,
+// including rows SkipRow drops from further processing and rows
+// CollectErrors also aggregates into the returned *MultiError. It never
+// buffers the whole file, making it suitable for large CSVs that
+// ReadAll/Marshal would otherwise have to load into memory. If fn returns a
+// non-nil error, streaming stops immediately and that error is returned,
+// regardless of cfg.OnError.
+func (c csvHelperImpl[T]) Stream(buffer io.Reader, cfg MarshalConfig, fn func(row int, item T, err error) error) error {
+	reader := csv.NewReader(buffer)
+
+	var header []string
+	var indexToFieldName map[int]string
+
+	if cfg.Format != "" {
+		fields, err := parseFormat[T](cfg.Format, cfg.FormatDelimiter)
+		if err != nil {
+			return err
+		}
+
+		indexToFieldName = fields
+	} else {
+		h, err := reader.Read()
+		if err != nil {
+			return err
+		}
+		header = h
+
+		if !cfg.SkipValidation {
+			var model T
+			if len(header) != reflect.ValueOf(model).NumField() {
+				return ErrInvalidHeaderSize
+			}
+		}
+
+		fields, err := c.mapIndexToField([][]string{header})
+		if err != nil {
+			return err
+		}
+
+		indexToFieldName = fields
+	}
+
+	if !cfg.SkipValidation {
+		if err := c.validateModelTags(); err != nil {
+			return err
+		}
+	}
+
+	var fieldRules map[string][]ruleToken
+	var aliases map[string][]string
+
+	if !cfg.SkipValidation {
+		rules, fieldAliases, err := compileFieldRules[T]()
+		if err != nil {
+			return err
+		}
+
+		fieldRules, aliases = rules, fieldAliases
+	}
+
+	columns := fieldToColumnMap[T]()
+
+	var multiErr MultiError
+	row := 0
+
+	for {
+		record, readErr := reader.Read()
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return readErr
+		}
+
+		row++
+
+		item, convErr := assignRecord[T](record, indexToFieldName, header, row, cfg)
+		if convErr == nil && !cfg.SkipValidation {
+			if verrs := validateModel[T](item, fieldRules, aliases, columns, row); len(verrs) > 0 {
+				convErr = verrs
+			}
+		}
+
+		if err := fn(row, item, convErr); err != nil {
+			return err
+		}
+
+		if convErr != nil {
+			switch cfg.OnError {
+			case SkipRow:
+				continue
+			case CollectErrors:
+				multiErr.Errors = append(multiErr.Errors, convErr)
+				continue
+			default: // StopOnError
+				return convErr
+			}
+		}
+	}
+
+	if len(multiErr.Errors) > 0 {
+		return &multiErr
+	}
+
+	return nil
+}
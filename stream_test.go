@@ -0,0 +1,110 @@
+package csvhelper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var streamCsvFile = `column1,column2,column3
+value1,value2,value3
+value4,value5,value6
+`
+
+func TestStream(t *testing.T) {
+	csvHelper := New[validModelT]()
+
+	var rows []int
+	var items []validModelT
+
+	err := csvHelper.Stream(bytes.NewBufferString(streamCsvFile), MarshalConfig{}, func(row int, item validModelT, err error) error {
+		rows = append(rows, row)
+		items = append(items, item)
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, rows)
+	assert.Equal(t, []validModelT{
+		{Field1: "value1", Field2: "value2", Field3: "value3"},
+		{Field1: "value4", Field2: "value5", Field3: "value6"},
+	}, items)
+}
+
+func TestStreamStopOnError(t *testing.T) {
+	csvHelper := New[typedModelT]()
+
+	err := csvHelper.Stream(bytes.NewBufferString(`name,age,score,active,joins_at
+Alice,notanumber,9.5,true,2023-05-01T00:00:00Z
+Bob,40,8.1,true,2023-05-01T00:00:00Z
+`), MarshalConfig{}, func(row int, item typedModelT, err error) error {
+		return err
+	})
+
+	assert.Error(t, err)
+
+	var fieldErr *FieldError
+	assert.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, 1, fieldErr.Row)
+}
+
+func TestStreamSkipRow(t *testing.T) {
+	csvHelper := New[typedModelT]()
+
+	var seen []string
+
+	err := csvHelper.Stream(bytes.NewBufferString(`name,age,score,active,joins_at
+Alice,notanumber,9.5,true,2023-05-01T00:00:00Z
+Bob,40,8.1,true,2023-05-01T00:00:00Z
+`), MarshalConfig{OnError: SkipRow}, func(row int, item typedModelT, err error) error {
+		if err == nil {
+			seen = append(seen, item.Name)
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Bob"}, seen)
+}
+
+// TestStreamCallsFnOnEveryRowRegardlessOfPolicy confirms fn observes a
+// failing row's error under SkipRow and CollectErrors too, not just
+// StopOnError - Stream documents that fn sees every row, with any
+// conversion/validation error, under every ErrorPolicy.
+func TestStreamCallsFnOnEveryRowRegardlessOfPolicy(t *testing.T) {
+	for _, policy := range []ErrorPolicy{SkipRow, CollectErrors} {
+		csvHelper := New[typedModelT]()
+
+		var errsSeen []error
+
+		_ = csvHelper.Stream(bytes.NewBufferString(`name,age,score,active,joins_at
+Alice,notanumber,9.5,true,2023-05-01T00:00:00Z
+Bob,40,8.1,true,2023-05-01T00:00:00Z
+`), MarshalConfig{OnError: policy}, func(row int, item typedModelT, err error) error {
+			errsSeen = append(errsSeen, err)
+			return nil
+		})
+
+		assert.Len(t, errsSeen, 2)
+		assert.Error(t, errsSeen[0])
+		assert.NoError(t, errsSeen[1])
+	}
+}
+
+func TestStreamCollectErrors(t *testing.T) {
+	csvHelper := New[typedModelT]()
+
+	err := csvHelper.Stream(bytes.NewBufferString(`name,age,score,active,joins_at
+Alice,notanumber,9.5,true,2023-05-01T00:00:00Z
+Bob,alsonotanumber,8.1,true,2023-05-01T00:00:00Z
+`), MarshalConfig{OnError: CollectErrors}, func(row int, item typedModelT, err error) error {
+		return nil
+	})
+
+	assert.Error(t, err)
+
+	var multiErr *MultiError
+	assert.ErrorAs(t, err, &multiErr)
+	assert.Len(t, multiErr.Errors, 2)
+}